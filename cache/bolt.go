@@ -0,0 +1,94 @@
+package cache
+
+import (
+	"fmt"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+var bucketName = []byte("responses")
+
+// BoltCache is an on-disk Cache backed by BoltDB, used when the process
+// should survive restarts (e.g. the HTTP transport running as a long-lived
+// service rather than a per-call stdio process).
+type BoltCache struct {
+	db *bolt.DB
+}
+
+// NewBoltCache opens (creating if necessary) a BoltDB file at path.
+func NewBoltCache(path string) (*BoltCache, error) {
+	db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: 1 * time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("opening bolt cache at %s: %w", path, err)
+	}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(bucketName)
+		return err
+	})
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("creating cache bucket: %w", err)
+	}
+
+	return &BoltCache{db: db}, nil
+}
+
+// Get implements Cache.
+func (c *BoltCache) Get(key string) ([]byte, bool) {
+	var value []byte
+	var expiresAt int64
+
+	err := c.db.View(func(tx *bolt.Tx) error {
+		raw := tx.Bucket(bucketName).Get([]byte(key))
+		if raw == nil {
+			return nil
+		}
+		if len(raw) < 8 {
+			return nil
+		}
+		expiresAt = decodeExpiry(raw[:8])
+		value = append([]byte(nil), raw[8:]...)
+		return nil
+	})
+	if err != nil || value == nil {
+		return nil, false
+	}
+
+	if time.Now().Unix() > expiresAt {
+		return nil, false
+	}
+	return value, true
+}
+
+// Set implements Cache.
+func (c *BoltCache) Set(key string, value []byte, ttl time.Duration) error {
+	expiresAt := time.Now().Add(ttl).Unix()
+	raw := append(encodeExpiry(expiresAt), value...)
+
+	return c.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(bucketName).Put([]byte(key), raw)
+	})
+}
+
+// Close implements Cache.
+func (c *BoltCache) Close() error {
+	return c.db.Close()
+}
+
+func encodeExpiry(unixSeconds int64) []byte {
+	b := make([]byte, 8)
+	for i := 0; i < 8; i++ {
+		b[i] = byte(unixSeconds >> (56 - 8*i))
+	}
+	return b
+}
+
+func decodeExpiry(b []byte) int64 {
+	var v int64
+	for i := 0; i < 8; i++ {
+		v = v<<8 | int64(b[i])
+	}
+	return v
+}
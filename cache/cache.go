@@ -0,0 +1,46 @@
+// Package cache provides a pluggable response cache for upstream Entur
+// endpoints, so repeated tool calls don't hammer api.entur.io.
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Default TTLs for the two upstream endpoints the MCP server proxies.
+const (
+	GeocoderTTL = 7 * 24 * time.Hour
+	TripTTL     = 60 * time.Second
+)
+
+// Cache stores and retrieves raw response bodies keyed by a request hash.
+// Implementations must be safe for concurrent use.
+type Cache interface {
+	// Get returns the cached value for key and true if it exists and has
+	// not expired.
+	Get(key string) ([]byte, bool)
+	// Set stores value under key with the given time-to-live.
+	Set(key string, value []byte, ttl time.Duration) error
+	// Close releases any resources held by the cache.
+	Close() error
+}
+
+// Key canonicalizes a request (an endpoint plus a set of parameters) into a
+// stable cache key so equivalent requests hash to the same value regardless
+// of parameter ordering.
+func Key(endpoint string, params map[string]string) string {
+	parts := make([]string, 0, len(params))
+	for k, v := range params {
+		parts = append(parts, k+"="+v)
+	}
+	sort.Strings(parts)
+
+	h := sha256.New()
+	h.Write([]byte(endpoint))
+	h.Write([]byte("\x00"))
+	h.Write([]byte(strings.Join(parts, "&")))
+	return hex.EncodeToString(h.Sum(nil))
+}
@@ -0,0 +1,97 @@
+// Command opentripplanner-mcp runs the OpenTripPlanner MCP server: it wires
+// together the Entur clients, the MCP tools, and a transport (stdio or
+// streamable HTTP), then serves until the client disconnects or the
+// process is killed.
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/server"
+
+	"github.com/entur/opentripplanner-mcp/cache"
+	"github.com/entur/opentripplanner-mcp/config"
+	"github.com/entur/opentripplanner-mcp/internal/entur/geocoder"
+	"github.com/entur/opentripplanner-mcp/internal/entur/journeyplanner"
+	"github.com/entur/opentripplanner-mcp/internal/tools"
+	"github.com/entur/opentripplanner-mcp/internal/transport"
+)
+
+func main() {
+	log.SetOutput(os.Stderr)
+
+	cfg := config.LoadConfig()
+
+	transportMode := getEnv("TRANSPORT", "stdio")
+	flag.Func("transport", "transport to serve on: stdio, http, or sse", func(v string) error {
+		transportMode = v
+		return nil
+	})
+	flag.Parse()
+
+	geocoderURL := cfg.GeocoderURL
+	log.Printf("Initializing OpenTripPlanner MCP server (Environment: %s, Transport: %s)", cfg.Environment, transportMode)
+
+	respCache := newResponseCache()
+	defer respCache.Close()
+
+	journeyPlannerClient := journeyplanner.NewClient(cfg.APIURL, cfg.ClientName, respCache)
+	geocoderClient := geocoder.NewClient(geocoderURL, cfg.ClientName, respCache)
+
+	s := server.NewMCPServer(
+		"OpenTripPlanner",
+		"1.0.0",
+		server.WithToolCapabilities(false),
+		server.WithRecovery(),
+	)
+
+	tools.RegisterTrip(s, journeyPlannerClient, geocoderClient)
+	tools.RegisterGeocode(s, geocoderClient)
+	tools.RegisterNearbyDepartures(s, journeyPlannerClient, geocoderClient)
+	log.Println("Server configured with tools: trip, geocode, nearbyDepartures")
+
+	var err error
+	switch transportMode {
+	case "http", "streamable-http":
+		log.Printf("Starting OpenTripPlanner MCP server using %s transport on port %d", transportMode, cfg.Port)
+		err = transport.ServeHTTP(s, cfg.Port)
+	case "sse":
+		log.Printf("Starting OpenTripPlanner MCP server using sse transport on port %d", cfg.Port)
+		err = transport.ServeSSE(s, cfg.Port)
+	default:
+		log.Println("Starting OpenTripPlanner MCP server using stdio transport")
+		err = transport.ServeStdio(s)
+	}
+	if err != nil {
+		log.Fatalf("Transport failed: %v", err)
+	}
+
+	log.Println("Server shutdown complete")
+}
+
+// newResponseCache builds the cache backend selected by CACHE_BACKEND,
+// fronting both upstream Entur endpoints so repeated tool calls don't
+// re-hit api.entur.io.
+func newResponseCache() cache.Cache {
+	if getEnv("CACHE_BACKEND", "memory") != "bolt" {
+		return cache.NewMemoryCache()
+	}
+
+	path := getEnv("CACHE_PATH", "opentripplanner-mcp-cache.db")
+	boltCache, err := cache.NewBoltCache(path)
+	if err != nil {
+		log.Fatalf("Failed to open bolt cache at %s: %v", path, err)
+	}
+	return boltCache
+}
+
+func getEnv(key, defaultValue string) string {
+	value := strings.TrimSpace(os.Getenv(key))
+	if value == "" {
+		return defaultValue
+	}
+	return value
+}
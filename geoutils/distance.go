@@ -0,0 +1,64 @@
+// Package geoutils provides small geometric helpers for re-scoring Entur
+// trip results against geometry rather than trusting reported leg fields
+// blindly.
+package geoutils
+
+import (
+	"math"
+
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/geo"
+)
+
+// DistanceFromLineString finds the point on line closest to point and
+// returns the great-circle distance to it in meters, along with the index
+// of the segment (line[closestIndex], line[closestIndex+1]) it falls on.
+//
+// Each segment is treated as locally planar for the projection itself (t is
+// computed from raw lon/lat deltas), which is an adequate approximation at
+// the scale of a single walking leg; only the final distance is computed
+// with the great-circle formula.
+func DistanceFromLineString(point orb.Point, line orb.LineString) (distance float64, closestIndex int) {
+	if len(line) == 0 {
+		return 0, -1
+	}
+	if len(line) == 1 {
+		return geo.Distance(point, line[0]), 0
+	}
+
+	minDistance := math.Inf(1)
+	minIndex := 0
+
+	for i := 0; i < len(line)-1; i++ {
+		a, b := line[i], line[i+1]
+
+		abx, aby := b[0]-a[0], b[1]-a[1]
+		lengthSq := abx*abx + aby*aby
+
+		t := 0.0
+		if lengthSq > 0 {
+			t = ((point[0]-a[0])*abx + (point[1]-a[1])*aby) / lengthSq
+			t = clamp(t, 0, 1)
+		}
+
+		closest := orb.Point{a[0] + t*abx, a[1] + t*aby}
+		d := geo.Distance(point, closest)
+
+		if d < minDistance {
+			minDistance = d
+			minIndex = i
+		}
+	}
+
+	return minDistance, minIndex
+}
+
+func clamp(v, lo, hi float64) float64 {
+	if v < lo {
+		return lo
+	}
+	if v > hi {
+		return hi
+	}
+	return v
+}
@@ -0,0 +1,93 @@
+package geoutils
+
+import (
+	"math"
+	"testing"
+
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/geo"
+)
+
+func TestDistanceFromLineString(t *testing.T) {
+	tests := []struct {
+		name        string
+		point       orb.Point
+		line        orb.LineString
+		wantClosest orb.Point
+		wantIndex   int
+	}{
+		{
+			name:        "point projects onto the middle of the segment",
+			point:       orb.Point{5, 1},
+			line:        orb.LineString{{0, 0}, {10, 0}},
+			wantClosest: orb.Point{5, 0},
+			wantIndex:   0,
+		},
+		{
+			name:        "point off one end clamps to the start vertex",
+			point:       orb.Point{-5, 1},
+			line:        orb.LineString{{0, 0}, {10, 0}},
+			wantClosest: orb.Point{0, 0},
+			wantIndex:   0,
+		},
+		{
+			name:        "point off the other end clamps to the end vertex",
+			point:       orb.Point{15, 1},
+			line:        orb.LineString{{0, 0}, {10, 0}},
+			wantClosest: orb.Point{10, 0},
+			wantIndex:   0,
+		},
+		{
+			name:        "closest segment is not the first one",
+			point:       orb.Point{11, 5},
+			line:        orb.LineString{{0, 0}, {10, 0}, {10, 10}},
+			wantClosest: orb.Point{10, 5},
+			wantIndex:   1,
+		},
+		{
+			name:        "degenerate single-point line",
+			point:       orb.Point{3, 4},
+			line:        orb.LineString{{0, 0}},
+			wantClosest: orb.Point{0, 0},
+			wantIndex:   0,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			gotDistance, gotIndex := DistanceFromLineString(tc.point, tc.line)
+
+			if gotIndex != tc.wantIndex {
+				t.Errorf("closestIndex = %d, want %d", gotIndex, tc.wantIndex)
+			}
+
+			wantDistance := geo.Distance(tc.point, tc.wantClosest)
+			if math.Abs(gotDistance-wantDistance) > 1e-6 {
+				t.Errorf("distance = %v, want %v (distance to %v)", gotDistance, wantDistance, tc.wantClosest)
+			}
+		})
+	}
+}
+
+func TestDistanceFromLineStringEmptyLine(t *testing.T) {
+	distance, index := DistanceFromLineString(orb.Point{0, 0}, orb.LineString{})
+	if distance != 0 || index != -1 {
+		t.Errorf("DistanceFromLineString(_, empty) = (%v, %v), want (0, -1)", distance, index)
+	}
+}
+
+func TestClamp(t *testing.T) {
+	tests := []struct {
+		v, lo, hi, want float64
+	}{
+		{v: 0.5, lo: 0, hi: 1, want: 0.5},
+		{v: -1, lo: 0, hi: 1, want: 0},
+		{v: 2, lo: 0, hi: 1, want: 1},
+	}
+
+	for _, tc := range tests {
+		if got := clamp(tc.v, tc.lo, tc.hi); got != tc.want {
+			t.Errorf("clamp(%v, %v, %v) = %v, want %v", tc.v, tc.lo, tc.hi, got, tc.want)
+		}
+	}
+}
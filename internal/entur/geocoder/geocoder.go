@@ -0,0 +1,233 @@
+// Package geocoder is a typed client for Entur's geocoder autocomplete API,
+// and resolves the free-text/coordinate/GeoJSON location inputs accepted by
+// the MCP tools into coordinates.
+package geocoder
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/geojson"
+
+	"github.com/entur/opentripplanner-mcp/cache"
+)
+
+// Location is a resolved location: a place name or ID (used verbatim as
+// the Journey Planner "place" field when known) plus coordinates.
+type Location struct {
+	Place     string
+	Latitude  float64
+	Longitude float64
+}
+
+// Response is the geocoder autocomplete API's response. Features are kept
+// as raw JSON so the full upstream payload (labels, counties, localities,
+// categories, confidence, bbox, ...) round-trips to MCP clients unchanged;
+// only feature returns the handful of fields Resolve needs.
+type Response struct {
+	Features []json.RawMessage `json:"features"`
+}
+
+// feature is the subset of a geocoder feature's fields Resolve needs to
+// turn it into a Location.
+type feature struct {
+	Geometry struct {
+		Coordinates []float64 `json:"coordinates"`
+	} `json:"geometry"`
+	Properties struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"properties"`
+}
+
+// Client searches for locations and resolves the location inputs accepted
+// by the MCP tools (free text, "lat,lng", or a GeoJSON Feature/
+// FeatureCollection) into coordinates.
+type Client interface {
+	// Search returns up to maxResults geocoded features matching text.
+	Search(text string, maxResults int) (*Response, error)
+	// Resolve accepts a GeoJSON Feature/FeatureCollection, a "lat,lng"
+	// coordinate pair, or free text to geocode, and returns the first
+	// matching Location.
+	Resolve(location string) (*Location, error)
+}
+
+type httpClient struct {
+	url        string
+	clientName string
+	httpClient *http.Client
+	cache      cache.Cache
+}
+
+// NewClient creates a Client against the given geocoder autocomplete
+// endpoint. respCache may be nil to disable response caching.
+func NewClient(endpointURL, clientName string, respCache cache.Cache) Client {
+	return &httpClient{
+		url:        endpointURL,
+		clientName: clientName,
+		httpClient: &http.Client{},
+		cache:      respCache,
+	}
+}
+
+// Search implements Client.
+func (c *httpClient) Search(text string, maxResults int) (*Response, error) {
+	cacheKey := cache.Key(c.url, map[string]string{"text": text})
+
+	var body []byte
+	if c.cache != nil {
+		if cached, ok := c.cache.Get(cacheKey); ok {
+			body = cached
+		}
+	}
+
+	if body == nil {
+		searchURL := fmt.Sprintf("%s?text=%s", c.url, url.QueryEscape(text))
+
+		req, err := http.NewRequest("GET", searchURL, nil)
+		if err != nil {
+			return nil, fmt.Errorf("creating geocoder request: %w", err)
+		}
+		req.Header.Add("ET-Client-Name", c.clientName)
+
+		resp, err := c.httpClient.Do(req)
+		if err != nil {
+			return nil, fmt.Errorf("sending geocoder request: %w", err)
+		}
+		defer resp.Body.Close()
+
+		respBody, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, fmt.Errorf("reading geocoder response: %w", err)
+		}
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("geocoder API returned status %d: %s", resp.StatusCode, string(respBody))
+		}
+
+		if c.cache != nil {
+			if err := c.cache.Set(cacheKey, respBody, cache.GeocoderTTL); err != nil {
+				log.Printf("Warning: failed to cache geocoder response: %v", err)
+			}
+		}
+		body = respBody
+	}
+
+	var result Response
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("decoding geocoder response: %w", err)
+	}
+	if len(result.Features) > maxResults {
+		result.Features = result.Features[:maxResults]
+	}
+	return &result, nil
+}
+
+// Resolve implements Client.
+func (c *httpClient) Resolve(location string) (*Location, error) {
+	if loc, ok := locationFromGeoJSON(location); ok {
+		return loc, nil
+	}
+
+	if loc, ok := locationFromCoordinates(location); ok {
+		return loc, nil
+	}
+
+	result, err := c.Search(location, 1)
+	if err != nil {
+		return nil, err
+	}
+	if len(result.Features) == 0 {
+		return nil, fmt.Errorf("no locations found for: %s", location)
+	}
+
+	var f feature
+	if err := json.Unmarshal(result.Features[0], &f); err != nil {
+		return nil, fmt.Errorf("decoding geocoder feature for: %s: %w", location, err)
+	}
+	if len(f.Geometry.Coordinates) < 2 {
+		return nil, fmt.Errorf("invalid coordinates in feature for: %s", location)
+	}
+
+	name := f.Properties.Name
+	if name == "" {
+		name = "location"
+	}
+
+	return &Location{
+		Place:     name,
+		Longitude: f.Geometry.Coordinates[0],
+		Latitude:  f.Geometry.Coordinates[1],
+	}, nil
+}
+
+// locationFromCoordinates parses location as a "lat,lng" pair.
+func locationFromCoordinates(location string) (*Location, bool) {
+	parts := strings.Split(location, ",")
+	if len(parts) != 2 {
+		return nil, false
+	}
+
+	var lat, lng float64
+	if _, err := fmt.Sscanf(parts[0], "%f", &lat); err != nil {
+		return nil, false
+	}
+	if _, err := fmt.Sscanf(parts[1], "%f", &lng); err != nil {
+		return nil, false
+	}
+
+	return &Location{Place: "coordinate", Latitude: lat, Longitude: lng}, true
+}
+
+// locationFromGeoJSON parses location as a GeoJSON Feature or
+// FeatureCollection and returns the Location described by its first
+// point-geometry feature. A "properties.id" (e.g. "NSR:StopPlace:...") is
+// honored as the place so stop IDs from the geocoder round-trip exactly;
+// otherwise "properties.name" is used.
+func locationFromGeoJSON(location string) (*Location, bool) {
+	trimmed := strings.TrimSpace(location)
+	if !strings.HasPrefix(trimmed, "{") {
+		return nil, false
+	}
+
+	if fc, err := geojson.UnmarshalFeatureCollection([]byte(trimmed)); err == nil {
+		for _, feature := range fc.Features {
+			if loc, ok := locationFromFeature(feature); ok {
+				return loc, true
+			}
+		}
+		return nil, false
+	}
+
+	if feature, err := geojson.UnmarshalFeature([]byte(trimmed)); err == nil {
+		return locationFromFeature(feature)
+	}
+
+	return nil, false
+}
+
+func locationFromFeature(feature *geojson.Feature) (*Location, bool) {
+	point, ok := feature.Geometry.(orb.Point)
+	if !ok {
+		return nil, false
+	}
+
+	place, _ := feature.Properties["name"].(string)
+	if id, ok := feature.Properties["id"].(string); ok && id != "" {
+		place = id
+	}
+	if place == "" {
+		place = "location"
+	}
+
+	return &Location{
+		Place:     place,
+		Latitude:  point.Lat(),
+		Longitude: point.Lon(),
+	}, true
+}
@@ -0,0 +1,109 @@
+package geocoder
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestSearchReturnsFeatures(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("text"); got != "Oslo" {
+			t.Errorf("text = %q, want %q", got, "Oslo")
+		}
+		w.Write([]byte(`{"features": [
+			{"geometry": {"coordinates": [10.75, 59.91]}, "properties": {"id": "NSR:StopPlace:1", "name": "Oslo S"}},
+			{"geometry": {"coordinates": [10.8, 59.92]}, "properties": {"id": "NSR:StopPlace:2", "name": "Oslo bus terminal"}}
+		]}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-client", nil)
+
+	resp, err := client.Search("Oslo", 1)
+	if err != nil {
+		t.Fatalf("Search returned error: %v", err)
+	}
+	if len(resp.Features) != 1 {
+		t.Fatalf("len(Features) = %d, want 1 (maxResults should truncate)", len(resp.Features))
+	}
+	var f feature
+	if err := json.Unmarshal(resp.Features[0], &f); err != nil {
+		t.Fatalf("unmarshaling feature: %v", err)
+	}
+	if f.Properties.Name != "Oslo S" {
+		t.Errorf("Features[0].Properties.Name = %q, want %q", f.Properties.Name, "Oslo S")
+	}
+}
+
+func TestSearchPassesThroughFullFeaturePayload(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"features": [
+			{"geometry": {"coordinates": [10.75, 59.91]}, "properties": {"id": "NSR:StopPlace:1", "name": "Oslo S", "label": "Oslo S, Oslo, Norway", "category": ["railStation"], "confidence": 1}}
+		]}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-client", nil)
+
+	resp, err := client.Search("Oslo", 5)
+	if err != nil {
+		t.Fatalf("Search returned error: %v", err)
+	}
+	if len(resp.Features) != 1 {
+		t.Fatalf("len(Features) = %d, want 1", len(resp.Features))
+	}
+	if !strings.Contains(string(resp.Features[0]), "Oslo S, Oslo, Norway") {
+		t.Errorf("Features[0] = %s, want upstream fields (e.g. label) preserved", resp.Features[0])
+	}
+}
+
+func TestResolveCoordinates(t *testing.T) {
+	client := NewClient("http://unused.invalid", "test-client", nil)
+
+	loc, err := client.Resolve("59.91,10.75")
+	if err != nil {
+		t.Fatalf("Resolve returned error: %v", err)
+	}
+	if loc.Latitude != 59.91 || loc.Longitude != 10.75 {
+		t.Errorf("Resolve(\"59.91,10.75\") = %+v, want lat=59.91 lng=10.75", loc)
+	}
+}
+
+func TestResolveGeoJSONFeature(t *testing.T) {
+	client := NewClient("http://unused.invalid", "test-client", nil)
+
+	feature := `{"type": "Feature", "geometry": {"type": "Point", "coordinates": [10.75, 59.91]}, "properties": {"id": "NSR:StopPlace:1", "name": "Oslo S"}}`
+
+	loc, err := client.Resolve(feature)
+	if err != nil {
+		t.Fatalf("Resolve returned error: %v", err)
+	}
+	if loc.Place != "NSR:StopPlace:1" {
+		t.Errorf("Place = %q, want the feature's properties.id", loc.Place)
+	}
+	if loc.Latitude != 59.91 || loc.Longitude != 10.75 {
+		t.Errorf("Resolve(feature) = %+v, want lat=59.91 lng=10.75", loc)
+	}
+}
+
+func TestResolveGeocodesFreeText(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"features": [
+			{"geometry": {"coordinates": [10.75, 59.91]}, "properties": {"name": "Oslo S"}}
+		]}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-client", nil)
+
+	loc, err := client.Resolve("Oslo S")
+	if err != nil {
+		t.Fatalf("Resolve returned error: %v", err)
+	}
+	if loc.Place != "Oslo S" {
+		t.Errorf("Place = %q, want %q", loc.Place, "Oslo S")
+	}
+}
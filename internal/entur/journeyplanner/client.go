@@ -0,0 +1,145 @@
+// Package journeyplanner is a typed client for Entur's Journey Planner
+// GraphQL API, used to plan trips between two locations.
+package journeyplanner
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+
+	"github.com/entur/opentripplanner-mcp/cache"
+)
+
+// API is a Journey Planner client, satisfied by *Client. Callers that need
+// to fake the Journey Planner in tests can implement this directly instead
+// of standing up an httptest.Server.
+type API interface {
+	Trip(query TripQuery) (*TripResponse, error)
+	NearbyDepartures(query NearbyDeparturesQuery) (*NearbyDeparturesResponse, error)
+}
+
+// Client issues Journey Planner GraphQL requests against a single API
+// endpoint.
+type Client struct {
+	apiURL     string
+	clientName string
+	httpClient *http.Client
+
+	// cache fronts the API so repeated identical trip searches don't
+	// re-hit Entur. Optional; nil disables caching.
+	cache cache.Cache
+}
+
+// NewClient creates a Client for the given Journey Planner GraphQL endpoint.
+// clientName is sent as the ET-Client-Name header Entur requires of all API
+// consumers. respCache may be nil to disable response caching.
+func NewClient(apiURL, clientName string, respCache cache.Cache) *Client {
+	return &Client{
+		apiURL:     apiURL,
+		clientName: clientName,
+		httpClient: &http.Client{},
+		cache:      respCache,
+	}
+}
+
+type graphQLRequest struct {
+	Query     string                 `json:"query"`
+	Variables map[string]interface{} `json:"variables"`
+}
+
+type graphQLResponse struct {
+	Data   json.RawMessage `json:"data"`
+	Errors []GraphQLError  `json:"errors"`
+}
+
+// Trip runs a trip search for the given query.
+func (c *Client) Trip(query TripQuery) (*TripResponse, error) {
+	reqBody := graphQLRequest{
+		Query:     tripQueryDocument,
+		Variables: query.variables(),
+	}
+
+	data, err := c.do(reqBody)
+	if err != nil {
+		return nil, err
+	}
+
+	var trip struct {
+		Trip TripResponse `json:"trip"`
+	}
+	if err := json.Unmarshal(data, &trip); err != nil {
+		return nil, fmt.Errorf("decoding trip response: %w", err)
+	}
+	return &trip.Trip, nil
+}
+
+// do sends a GraphQL request and returns the raw "data" payload, or an error
+// built from the GraphQL "errors" array if the query failed.
+func (c *Client) do(reqBody graphQLRequest) (json.RawMessage, error) {
+	variablesJSON, err := json.Marshal(reqBody.Variables)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling GraphQL variables: %w", err)
+	}
+
+	var cacheKey string
+	if c.cache != nil {
+		cacheKey = cache.Key(c.apiURL, map[string]string{
+			"query":     reqBody.Query,
+			"variables": string(variablesJSON),
+		})
+		if cached, ok := c.cache.Get(cacheKey); ok {
+			return cached, nil
+		}
+	}
+
+	reqJSON, err := json.Marshal(reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling GraphQL request: %w", err)
+	}
+
+	req, err := http.NewRequest("POST", c.apiURL, bytes.NewReader(reqJSON))
+	if err != nil {
+		return nil, fmt.Errorf("creating GraphQL request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("ET-Client-Name", c.clientName)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("sending GraphQL request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading GraphQL response: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("journey planner API returned status %d: %s", resp.StatusCode, string(body))
+	}
+
+	var gqlResp graphQLResponse
+	if err := json.Unmarshal(body, &gqlResp); err != nil {
+		return nil, fmt.Errorf("decoding GraphQL response: %w", err)
+	}
+
+	if len(gqlResp.Errors) > 0 {
+		first := gqlResp.Errors[0]
+		if first.Extensions.Code != "" {
+			return nil, fmt.Errorf("GraphQL query error [%s]: %s", first.Extensions.Code, first.Message)
+		}
+		return nil, fmt.Errorf("GraphQL query error: %s", first.Message)
+	}
+
+	if c.cache != nil {
+		if err := c.cache.Set(cacheKey, gqlResp.Data, cache.TripTTL); err != nil {
+			log.Printf("Warning: failed to cache GraphQL response: %v", err)
+		}
+	}
+
+	return gqlResp.Data, nil
+}
@@ -0,0 +1,99 @@
+package journeyplanner
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestTripReturnsPatterns(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("ET-Client-Name"); got != "test-client" {
+			t.Errorf("ET-Client-Name = %q, want %q", got, "test-client")
+		}
+		w.Write([]byte(`{"data": {"trip": {"tripPatterns": [
+			{"duration": 600, "startTime": "2023-05-26T12:00:00Z", "endTime": "2023-05-26T12:10:00Z", "legs": [
+				{"mode": "foot", "distance": 100, "duration": 120, "fromPlace": {"name": "A"}, "toPlace": {"name": "B"}}
+			]}
+		]}}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-client", nil)
+
+	resp, err := client.Trip(TripQuery{
+		From:            Location{Place: "A", Latitude: 59.91, Longitude: 10.75},
+		To:              Location{Place: "B", Latitude: 59.92, Longitude: 10.76},
+		NumTripPatterns: 3,
+	})
+	if err != nil {
+		t.Fatalf("Trip returned error: %v", err)
+	}
+	if len(resp.TripPatterns) != 1 {
+		t.Fatalf("len(TripPatterns) = %d, want 1", len(resp.TripPatterns))
+	}
+	if resp.TripPatterns[0].Duration != 600 {
+		t.Errorf("Duration = %d, want 600", resp.TripPatterns[0].Duration)
+	}
+}
+
+func TestTripSendsModesAsBareStrings(t *testing.T) {
+	var gotVariables struct {
+		TransportModes []string `json:"transportModes"`
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Variables json.RawMessage `json:"variables"`
+		}
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatalf("reading request body: %v", err)
+		}
+		if err := json.Unmarshal(body, &req); err != nil {
+			t.Fatalf("unmarshaling request body: %v", err)
+		}
+		if err := json.Unmarshal(req.Variables, &gotVariables); err != nil {
+			t.Fatalf("unmarshaling variables: %v", err)
+		}
+		w.Write([]byte(`{"data": {"trip": {"tripPatterns": []}}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-client", nil)
+
+	if _, err := client.Trip(TripQuery{
+		From:            Location{Latitude: 59.91, Longitude: 10.75},
+		To:              Location{Latitude: 59.92, Longitude: 10.76},
+		NumTripPatterns: 1,
+		Modes:           []string{"bus", "rail"},
+	}); err != nil {
+		t.Fatalf("Trip returned error: %v", err)
+	}
+
+	want := []string{"bus", "rail"}
+	if len(gotVariables.TransportModes) != len(want) {
+		t.Fatalf("transportModes = %v, want %v", gotVariables.TransportModes, want)
+	}
+	for i, mode := range want {
+		if gotVariables.TransportModes[i] != mode {
+			t.Errorf("transportModes[%d] = %q, want %q", i, gotVariables.TransportModes[i], mode)
+		}
+	}
+}
+
+func TestTripReturnsGraphQLError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"errors": [{"message": "location not found", "extensions": {"code": "NOT_FOUND"}}]}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-client", nil)
+
+	_, err := client.Trip(TripQuery{NumTripPatterns: 1})
+	if err == nil {
+		t.Fatal("Trip returned nil error, want GraphQL error to surface")
+	}
+}
@@ -0,0 +1,179 @@
+package journeyplanner
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// NearbyDeparturesQuery describes a single `nearbyDepartures` request.
+type NearbyDeparturesQuery struct {
+	Latitude  float64
+	Longitude float64
+
+	// RadiusMeters is the maximum distance from (Latitude, Longitude) to
+	// search for stop places/quays.
+	RadiusMeters int
+
+	// Modes restricts results to the given transport modes. Empty means no
+	// restriction.
+	Modes []string
+
+	// TimeWindowMinutes bounds how far ahead departures are searched for.
+	TimeWindowMinutes int
+
+	MaxResults int
+}
+
+// EstimatedCall is one realtime departure.
+type EstimatedCall struct {
+	AimedDepartureTime    string `json:"aimedDepartureTime"`
+	ExpectedDepartureTime string `json:"expectedDepartureTime"`
+	Realtime              bool   `json:"realtime"`
+	Cancellation          bool   `json:"cancellation"`
+	DestinationDisplay    struct {
+		FrontText string `json:"frontText"`
+	} `json:"destinationDisplay"`
+	ServiceJourney struct {
+		Line struct {
+			PublicCode    string `json:"publicCode"`
+			TransportMode string `json:"transportMode"`
+		} `json:"line"`
+	} `json:"serviceJourney"`
+}
+
+// NearbyPlace is a stop place or quay with its upcoming departures.
+type NearbyPlace struct {
+	ID             string          `json:"id"`
+	Name           string          `json:"name"`
+	Distance       float64         `json:"distance"`
+	EstimatedCalls []EstimatedCall `json:"estimatedCalls"`
+}
+
+// NearbyDeparturesResponse is the `nearest` field of a Journey Planner
+// response, flattened into the places that matched.
+type NearbyDeparturesResponse struct {
+	Places []NearbyPlace
+}
+
+// NearbyDepartures finds the next realtime departures from stop
+// places/quays near the query's location.
+func (c *Client) NearbyDepartures(query NearbyDeparturesQuery) (*NearbyDeparturesResponse, error) {
+	reqBody := graphQLRequest{
+		Query:     nearbyDeparturesQueryDocument,
+		Variables: query.variables(),
+	}
+
+	data, err := c.do(reqBody)
+	if err != nil {
+		return nil, err
+	}
+
+	var nearest struct {
+		Nearest struct {
+			Edges []struct {
+				Node struct {
+					Distance float64 `json:"distance"`
+					Place    struct {
+						ID             string          `json:"id"`
+						Name           string          `json:"name"`
+						EstimatedCalls []EstimatedCall `json:"estimatedCalls"`
+					} `json:"place"`
+				} `json:"node"`
+			} `json:"edges"`
+		} `json:"nearest"`
+	}
+	if err := json.Unmarshal(data, &nearest); err != nil {
+		return nil, fmt.Errorf("decoding nearbyDepartures response: %w", err)
+	}
+
+	places := make([]NearbyPlace, 0, len(nearest.Nearest.Edges))
+	for _, edge := range nearest.Nearest.Edges {
+		places = append(places, NearbyPlace{
+			ID:             edge.Node.Place.ID,
+			Name:           edge.Node.Place.Name,
+			Distance:       edge.Node.Distance,
+			EstimatedCalls: edge.Node.Place.EstimatedCalls,
+		})
+	}
+
+	return &NearbyDeparturesResponse{Places: places}, nil
+}
+
+const nearbyDeparturesQueryDocument = `
+query nearbyDepartures(
+	$latitude: Float!
+	$longitude: Float!
+	$maximumDistance: Float
+	$numberOfDepartures: Int
+	$timeRange: Int
+	$transportModes: [TransportMode!]
+) {
+	nearest(
+		latitude: $latitude
+		longitude: $longitude
+		maximumDistance: $maximumDistance
+		filterByPlaceTypes: [quay, stopPlace]
+	) {
+		edges {
+			node {
+				distance
+				place {
+					... on StopPlace {
+						id
+						name
+						estimatedCalls(
+							numberOfDepartures: $numberOfDepartures
+							timeRange: $timeRange
+							whiteListed: { transportModes: $transportModes }
+						) {
+							aimedDepartureTime
+							expectedDepartureTime
+							realtime
+							cancellation
+							destinationDisplay { frontText }
+							serviceJourney { line { publicCode transportMode } }
+						}
+					}
+					... on Quay {
+						id
+						name
+						estimatedCalls(
+							numberOfDepartures: $numberOfDepartures
+							timeRange: $timeRange
+							whiteListed: { transportModes: $transportModes }
+						) {
+							aimedDepartureTime
+							expectedDepartureTime
+							realtime
+							cancellation
+							destinationDisplay { frontText }
+							serviceJourney { line { publicCode transportMode } }
+						}
+					}
+				}
+			}
+		}
+	}
+}
+`
+
+func (q NearbyDeparturesQuery) variables() map[string]interface{} {
+	vars := map[string]interface{}{
+		"latitude":           q.Latitude,
+		"longitude":          q.Longitude,
+		"maximumDistance":    q.RadiusMeters,
+		"numberOfDepartures": q.MaxResults,
+		"timeRange":          q.TimeWindowMinutes * 60,
+	}
+
+	if len(q.Modes) > 0 {
+		transportModes := make([]string, len(q.Modes))
+		for i, mode := range q.Modes {
+			transportModes[i] = strings.ToLower(mode)
+		}
+		vars["transportModes"] = transportModes
+	}
+
+	return vars
+}
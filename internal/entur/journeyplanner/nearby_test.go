@@ -0,0 +1,54 @@
+package journeyplanner
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestNearbyDeparturesSendsModesAsBareStrings(t *testing.T) {
+	var gotVariables struct {
+		TransportModes []string `json:"transportModes"`
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Variables json.RawMessage `json:"variables"`
+		}
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatalf("reading request body: %v", err)
+		}
+		if err := json.Unmarshal(body, &req); err != nil {
+			t.Fatalf("unmarshaling request body: %v", err)
+		}
+		if err := json.Unmarshal(req.Variables, &gotVariables); err != nil {
+			t.Fatalf("unmarshaling variables: %v", err)
+		}
+		w.Write([]byte(`{"data": {"nearest": {"edges": []}}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-client", nil)
+
+	if _, err := client.NearbyDepartures(NearbyDeparturesQuery{
+		Latitude:     59.91,
+		Longitude:    10.75,
+		RadiusMeters: 500,
+		Modes:        []string{"Bus", "Rail"},
+	}); err != nil {
+		t.Fatalf("NearbyDepartures returned error: %v", err)
+	}
+
+	want := []string{"bus", "rail"}
+	if len(gotVariables.TransportModes) != len(want) {
+		t.Fatalf("transportModes = %v, want %v", gotVariables.TransportModes, want)
+	}
+	for i, mode := range want {
+		if gotVariables.TransportModes[i] != mode {
+			t.Errorf("transportModes[%d] = %q, want %q", i, gotVariables.TransportModes[i], mode)
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package journeyplanner
+
+import "strings"
+
+// tripQueryDocument is the GraphQL document for a trip search, parameterized
+// entirely through variables so callers never need to touch query strings.
+const tripQueryDocument = `
+query trip(
+	$from: Location!
+	$to: Location!
+	$dateTime: DateTime
+	$arriveBy: Boolean
+	$numTripPatterns: Int
+	$transportModes: [TransportMode!]
+	$accessMode: StreetMode
+	$walkSpeed: Float
+	$wheelchairAccessible: Boolean
+) {
+	trip(
+		from: $from
+		to: $to
+		dateTime: $dateTime
+		arriveBy: $arriveBy
+		numTripPatterns: $numTripPatterns
+		modes: { accessMode: $accessMode, transportModes: $transportModes }
+		walkSpeed: $walkSpeed
+		wheelchairAccessible: $wheelchairAccessible
+	) {
+		tripPatterns {
+			duration
+			startTime
+			endTime
+			legs {
+				mode
+				distance
+				duration
+				fromPlace {
+					name
+				}
+				toPlace {
+					name
+				}
+				line {
+					publicCode
+					name
+				}
+				aimedStartTime
+				expectedStartTime
+				aimedEndTime
+				expectedEndTime
+				pointsOnLink {
+					points
+					length
+				}
+			}
+		}
+	}
+}
+`
+
+// locationVariable converts a Location into the shape the Journey Planner
+// `Location` input type expects.
+func locationVariable(loc Location) map[string]interface{} {
+	v := map[string]interface{}{
+		"coordinates": map[string]interface{}{
+			"latitude":  loc.Latitude,
+			"longitude": loc.Longitude,
+		},
+	}
+	if loc.Place != "" {
+		v["place"] = loc.Place
+	}
+	return v
+}
+
+// variables builds the GraphQL variables map for a TripQuery.
+func (q TripQuery) variables() map[string]interface{} {
+	vars := map[string]interface{}{
+		"from":            locationVariable(q.From),
+		"to":              locationVariable(q.To),
+		"numTripPatterns": q.NumTripPatterns,
+	}
+
+	if q.DateTime != "" {
+		vars["dateTime"] = q.DateTime
+		vars["arriveBy"] = q.ArriveBy
+	}
+	if len(q.Modes) > 0 {
+		transportModes := make([]string, len(q.Modes))
+		for i, mode := range q.Modes {
+			transportModes[i] = strings.ToLower(mode)
+		}
+		vars["transportModes"] = transportModes
+	}
+	if q.WalkSpeed > 0 {
+		vars["walkSpeed"] = q.WalkSpeed
+	}
+	if q.WheelchairAccessible {
+		vars["wheelchairAccessible"] = true
+	}
+	if q.IncludeBikeRental {
+		vars["accessMode"] = "bike_rental"
+	}
+
+	return vars
+}
@@ -0,0 +1,88 @@
+package journeyplanner
+
+// Location identifies one endpoint of a trip: either a free-text/ID place
+// together with resolved coordinates (the common case, once geocoded) or
+// bare coordinates.
+type Location struct {
+	Place     string  `json:"place,omitempty"`
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+// TripQuery describes a single Journey Planner `trip` request.
+type TripQuery struct {
+	From Location
+	To   Location
+
+	// DateTime is an ISO-8601 timestamp; its meaning depends on ArriveBy.
+	DateTime string
+	ArriveBy bool
+
+	NumTripPatterns int
+
+	// Modes restricts the trip to the given transport modes (e.g. "bus",
+	// "rail"). Empty means no restriction.
+	Modes []string
+
+	// WalkSpeed is the walking speed in m/s used to estimate access/egress
+	// legs. Zero leaves it at the Journey Planner default.
+	WalkSpeed float64
+
+	WheelchairAccessible bool
+	IncludeBikeRental    bool
+}
+
+// TripResponse is the `trip` field of a Journey Planner response.
+type TripResponse struct {
+	TripPatterns []TripPattern `json:"tripPatterns"`
+}
+
+// TripPattern is one itinerary option.
+type TripPattern struct {
+	Duration  int    `json:"duration"`
+	StartTime string `json:"startTime"`
+	EndTime   string `json:"endTime"`
+	Legs      []Leg  `json:"legs"`
+}
+
+// Leg is one leg (walk, wait, or ride) of a TripPattern.
+type Leg struct {
+	Mode              string        `json:"mode"`
+	Distance          float64       `json:"distance"`
+	Duration          int           `json:"duration"`
+	FromPlace         Place         `json:"fromPlace"`
+	ToPlace           Place         `json:"toPlace"`
+	Line              *Line         `json:"line,omitempty"`
+	AimedStartTime    string        `json:"aimedStartTime,omitempty"`
+	ExpectedStartTime string        `json:"expectedStartTime,omitempty"`
+	AimedEndTime      string        `json:"aimedEndTime,omitempty"`
+	ExpectedEndTime   string        `json:"expectedEndTime,omitempty"`
+	PointsOnLink      *PointsOnLink `json:"pointsOnLink,omitempty"`
+}
+
+// Place names one endpoint of a Leg.
+type Place struct {
+	Name string `json:"name"`
+}
+
+// PointsOnLink is an encoded polyline describing a Leg's geometry, as
+// returned by the Journey Planner API.
+type PointsOnLink struct {
+	Points string `json:"points"`
+	Length int    `json:"length"`
+}
+
+// Line identifies the public transport line operating a Leg.
+type Line struct {
+	PublicCode string `json:"publicCode"`
+	Name       string `json:"name"`
+}
+
+// GraphQLError is one entry of a GraphQL response's top-level "errors"
+// array.
+type GraphQLError struct {
+	Message    string `json:"message"`
+	Extensions struct {
+		Code string `json:"code"`
+	} `json:"extensions"`
+}
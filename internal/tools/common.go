@@ -0,0 +1,17 @@
+package tools
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// toolResultJSON marshals result as the tool's JSON text response.
+func toolResultJSON(result interface{}) (*mcp.CallToolResult, error) {
+	resultJSON, err := json.Marshal(result)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Error marshaling result: %v", err)), nil
+	}
+	return mcp.NewToolResultText(string(resultJSON)), nil
+}
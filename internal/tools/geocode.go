@@ -0,0 +1,43 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+
+	"github.com/entur/opentripplanner-mcp/internal/entur/geocoder"
+)
+
+// geocoderSearcher is the subset of geocoder.Client the geocode tool needs.
+type geocoderSearcher interface {
+	Search(text string, maxResults int) (*geocoder.Response, error)
+}
+
+// RegisterGeocode registers the "geocode" tool on s.
+func RegisterGeocode(s *server.MCPServer, client geocoderSearcher) {
+	tool := mcp.NewTool("geocode",
+		mcp.WithDescription("Search for locations by name or address"),
+		mcp.WithString("text", mcp.Required(), mcp.Description("Location text to search for")),
+		mcp.WithNumber("maxResults", mcp.Description("Maximum number of results to return")),
+	)
+
+	s.AddTool(tool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		text, err := request.RequireString("text")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		maxResults := 5
+		if mr, ok := request.GetArguments()["maxResults"].(float64); ok && mr > 0 {
+			maxResults = int(mr)
+		}
+
+		result, err := client.Search(text, maxResults)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error geocoding: %v", err)), nil
+		}
+		return toolResultJSON(result)
+	})
+}
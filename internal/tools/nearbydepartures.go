@@ -0,0 +1,88 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+
+	"github.com/entur/opentripplanner-mcp/internal/entur/journeyplanner"
+)
+
+// nearbyDeparturesPlanner is the subset of journeyplanner.API the
+// nearbyDepartures tool needs.
+type nearbyDeparturesPlanner interface {
+	NearbyDepartures(query journeyplanner.NearbyDeparturesQuery) (*journeyplanner.NearbyDeparturesResponse, error)
+}
+
+// RegisterNearbyDepartures registers the "nearbyDepartures" tool on s.
+func RegisterNearbyDepartures(s *server.MCPServer, planner nearbyDeparturesPlanner, geocoder geocoderResolver) {
+	tool := mcp.NewTool("nearbyDepartures",
+		mcp.WithDescription("Find the next realtime departures from stop places near a location"),
+		mcp.WithString("location", mcp.Required(), mcp.Description("Location to search near (address, place name, or \"lat,lng\")")),
+		mcp.WithNumber("radius", mcp.Description("Search radius in meters (default 500)")),
+		mcp.WithArray("modes", mcp.Description("Transport modes to include (bus, rail, tram, metro, water, air)")),
+		mcp.WithNumber("timeWindow", mcp.Description("How many minutes ahead to look for departures (default 20)")),
+		mcp.WithNumber("maxResults", mcp.Description("Maximum number of departures to return")),
+	)
+
+	s.AddTool(tool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		location, err := request.RequireString("location")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		args := request.GetArguments()
+		radius := 500
+		if r, ok := args["radius"].(float64); ok && r > 0 {
+			radius = int(r)
+		}
+
+		var modes []string
+		if rawModes, ok := args["modes"].([]interface{}); ok {
+			for _, m := range rawModes {
+				if mode, ok := m.(string); ok {
+					modes = append(modes, mode)
+				}
+			}
+		}
+
+		timeWindow := 20
+		if tw, ok := args["timeWindow"].(float64); ok && tw > 0 {
+			timeWindow = int(tw)
+		}
+
+		maxResults := 10
+		if mr, ok := args["maxResults"].(float64); ok && mr > 0 {
+			maxResults = int(mr)
+		}
+
+		result, err := FindNearbyDepartures(planner, geocoder, location, radius, modes, timeWindow, maxResults)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error finding nearby departures: %v", err)), nil
+		}
+		return toolResultJSON(result)
+	})
+}
+
+// FindNearbyDepartures resolves location and fetches upcoming departures
+// from stop places/quays within radius meters of it.
+func FindNearbyDepartures(planner nearbyDeparturesPlanner, geocoder geocoderResolver, location string, radius int, modes []string, timeWindowMinutes, maxResults int) (*journeyplanner.NearbyDeparturesResponse, error) {
+	log.Printf("Finding departures near '%s' within %dm", location, radius)
+
+	loc, err := geocoder.Resolve(location)
+	if err != nil {
+		return nil, fmt.Errorf("error geocoding location: %w", err)
+	}
+
+	return planner.NearbyDepartures(journeyplanner.NearbyDeparturesQuery{
+		Latitude:          loc.Latitude,
+		Longitude:         loc.Longitude,
+		RadiusMeters:      radius,
+		Modes:             modes,
+		TimeWindowMinutes: timeWindowMinutes,
+		MaxResults:        maxResults,
+	})
+}
@@ -0,0 +1,41 @@
+package tools
+
+import (
+	"testing"
+
+	"github.com/entur/opentripplanner-mcp/internal/entur/geocoder"
+	"github.com/entur/opentripplanner-mcp/internal/entur/journeyplanner"
+)
+
+type fakeNearbyPlanner struct {
+	response *journeyplanner.NearbyDeparturesResponse
+	err      error
+	gotQuery journeyplanner.NearbyDeparturesQuery
+}
+
+func (f *fakeNearbyPlanner) NearbyDepartures(query journeyplanner.NearbyDeparturesQuery) (*journeyplanner.NearbyDeparturesResponse, error) {
+	f.gotQuery = query
+	return f.response, f.err
+}
+
+func TestFindNearbyDeparturesResolvesLocationAndQueries(t *testing.T) {
+	planner := &fakeNearbyPlanner{response: &journeyplanner.NearbyDeparturesResponse{
+		Places: []journeyplanner.NearbyPlace{{ID: "NSR:StopPlace:1", Name: "Oslo S"}},
+	}}
+	geo := &fakeGeocoder{location: &geocoder.Location{Place: "Oslo S", Latitude: 59.91, Longitude: 10.75}}
+
+	result, err := FindNearbyDepartures(planner, geo, "Oslo S", 500, []string{"bus"}, 20, 10)
+	if err != nil {
+		t.Fatalf("FindNearbyDepartures returned error: %v", err)
+	}
+	if len(result.Places) != 1 || result.Places[0].ID != "NSR:StopPlace:1" {
+		t.Errorf("Places = %+v, want the planner's fixed response", result.Places)
+	}
+
+	if planner.gotQuery.Latitude != 59.91 || planner.gotQuery.Longitude != 10.75 {
+		t.Errorf("query coordinates = (%v, %v), want the resolved location's (59.91, 10.75)", planner.gotQuery.Latitude, planner.gotQuery.Longitude)
+	}
+	if planner.gotQuery.RadiusMeters != 500 {
+		t.Errorf("RadiusMeters = %d, want 500", planner.gotQuery.RadiusMeters)
+	}
+}
@@ -0,0 +1,269 @@
+// Package tools defines the MCP tools exposed by the server: trip,
+// geocode, and nearbyDepartures.
+package tools
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/geojson"
+	polyline "github.com/twpayne/go-polyline"
+
+	"github.com/entur/opentripplanner-mcp/geoutils"
+	"github.com/entur/opentripplanner-mcp/internal/entur/geocoder"
+	"github.com/entur/opentripplanner-mcp/internal/entur/journeyplanner"
+)
+
+// TripOptions bundles the optional, less-frequently-used trip tool
+// parameters so the handler doesn't grow an ever-longer positional argument
+// list as more of the Journey Planner API is exposed.
+type TripOptions struct {
+	Modes                []string
+	WalkSpeed            float64
+	WheelchairAccessible bool
+	IncludeBikeRental    bool
+
+	// MaxWalkDistance filters out trip patterns whose access or egress walk
+	// exceeds this many meters, once re-scored against leg geometry. Zero
+	// disables filtering.
+	MaxWalkDistance float64
+}
+
+// TripResult is the trip tool's output: the Journey Planner trip patterns
+// plus, for each, a GeoJSON FeatureCollection of its leg geometries so
+// map-rendering clients can draw the itinerary without a second round trip.
+type TripResult struct {
+	TripPatterns []TripPatternResult `json:"tripPatterns"`
+}
+
+// TripPatternResult is a journeyplanner.TripPattern with its decoded leg
+// geometry and re-scored access/egress walk distances attached.
+type TripPatternResult struct {
+	journeyplanner.TripPattern
+	Geometry *geojson.FeatureCollection `json:"geometry,omitempty"`
+
+	// AccessWalkDistance/EgressWalkDistance are the true walking distances
+	// (meters) from the requested from/to coordinates to the first/last
+	// leg's geometry, computed via geoutils.DistanceFromLineString rather
+	// than trusted from the leg's reported distance field. Nil if the
+	// corresponding leg had no geometry to project onto.
+	AccessWalkDistance *float64 `json:"accessWalkDistance,omitempty"`
+	EgressWalkDistance *float64 `json:"egressWalkDistance,omitempty"`
+}
+
+// RegisterTrip registers the "trip" tool on s.
+func RegisterTrip(s *server.MCPServer, planner journeyplanner.API, geocoder geocoderResolver) {
+	tool := mcp.NewTool("trip",
+		mcp.WithDescription("Find trip options between two locations using Norwegian/Nordic public transport"),
+		mcp.WithString("from", mcp.Required(), mcp.Description("Starting location (address, place name, coordinates, or a GeoJSON Feature)")),
+		mcp.WithString("to", mcp.Required(), mcp.Description("Destination location (address, place name, coordinates, or a GeoJSON Feature)")),
+		mcp.WithString("departureTime", mcp.Description("Departure time in ISO format (e.g., 2023-05-26T12:00:00)")),
+		mcp.WithString("arrivalTime", mcp.Description("Arrival time in ISO format (e.g., 2023-05-26T14:00:00)")),
+		mcp.WithNumber("maxResults", mcp.Description("Maximum number of trip options to return")),
+		mcp.WithArray("modes", mcp.Description("Transport modes to restrict the trip to (bus, rail, tram, metro, water, air)")),
+		mcp.WithNumber("walkSpeed", mcp.Description("Walking speed in m/s used to estimate access/egress legs")),
+		mcp.WithBoolean("wheelchairAccessible", mcp.Description("Only return trip patterns usable with a wheelchair")),
+		mcp.WithBoolean("includeBikeRental", mcp.Description("Allow bike rental for access/egress legs")),
+		mcp.WithNumber("maxWalkDistance", mcp.Description("Filter out trip patterns whose access or egress walk exceeds this many meters")),
+	)
+
+	s.AddTool(tool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		from, err := request.RequireString("from")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		to, err := request.RequireString("to")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		args := request.GetArguments()
+		departureTime, _ := args["departureTime"].(string)
+		arrivalTime, _ := args["arrivalTime"].(string)
+
+		maxResults := 3
+		if mr, ok := args["maxResults"].(float64); ok && mr > 0 {
+			maxResults = int(mr)
+		}
+
+		var opts TripOptions
+		if rawModes, ok := args["modes"].([]interface{}); ok {
+			for _, m := range rawModes {
+				if mode, ok := m.(string); ok {
+					opts.Modes = append(opts.Modes, mode)
+				}
+			}
+		}
+		if ws, ok := args["walkSpeed"].(float64); ok && ws > 0 {
+			opts.WalkSpeed = ws
+		}
+		if wa, ok := args["wheelchairAccessible"].(bool); ok {
+			opts.WheelchairAccessible = wa
+		}
+		if br, ok := args["includeBikeRental"].(bool); ok {
+			opts.IncludeBikeRental = br
+		}
+		if mwd, ok := args["maxWalkDistance"].(float64); ok && mwd > 0 {
+			opts.MaxWalkDistance = mwd
+		}
+
+		result, err := PlanTrip(planner, geocoder, from, to, departureTime, arrivalTime, maxResults, opts)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error planning trip: %v", err)), nil
+		}
+		return toolResultJSON(result)
+	})
+}
+
+// geocoderResolver is the subset of geocoder.Client the tools package
+// needs: resolving a location input into coordinates.
+type geocoderResolver interface {
+	Resolve(location string) (*geocoder.Location, error)
+}
+
+// PlanTrip resolves from/to, runs the trip search, and re-scores the
+// resulting patterns against their leg geometry.
+func PlanTrip(planner journeyplanner.API, geocoder geocoderResolver, from, to, departureTime, arrivalTime string, maxResults int, opts TripOptions) (*TripResult, error) {
+	log.Printf("Planning trip from '%s' to '%s'", from, to)
+
+	fromLocation, err := geocoder.Resolve(from)
+	if err != nil {
+		return nil, fmt.Errorf("error geocoding 'from' location: %w", err)
+	}
+	toLocation, err := geocoder.Resolve(to)
+	if err != nil {
+		return nil, fmt.Errorf("error geocoding 'to' location: %w", err)
+	}
+
+	query := journeyplanner.TripQuery{
+		From: journeyplanner.Location{
+			Place:     fromLocation.Place,
+			Latitude:  fromLocation.Latitude,
+			Longitude: fromLocation.Longitude,
+		},
+		To: journeyplanner.Location{
+			Place:     toLocation.Place,
+			Latitude:  toLocation.Latitude,
+			Longitude: toLocation.Longitude,
+		},
+		NumTripPatterns:      maxResults,
+		Modes:                opts.Modes,
+		WalkSpeed:            opts.WalkSpeed,
+		WheelchairAccessible: opts.WheelchairAccessible,
+		IncludeBikeRental:    opts.IncludeBikeRental,
+	}
+
+	if departureTime != "" {
+		query.DateTime = departureTime
+	} else if arrivalTime != "" {
+		query.DateTime = arrivalTime
+		query.ArriveBy = true
+	}
+
+	trip, err := planner.Trip(query)
+	if err != nil {
+		return nil, err
+	}
+
+	fromPoint := orb.Point{fromLocation.Longitude, fromLocation.Latitude}
+	toPoint := orb.Point{toLocation.Longitude, toLocation.Latitude}
+
+	patterns := make([]TripPatternResult, 0, len(trip.TripPatterns))
+	for _, pattern := range trip.TripPatterns {
+		legLines := decodeLegLines(pattern)
+		result := TripPatternResult{
+			TripPattern: pattern,
+			Geometry:    patternGeometry(pattern, legLines),
+		}
+
+		if access, ok := accessEgressDistance(legLines, fromPoint, true); ok {
+			result.AccessWalkDistance = &access
+		}
+		if egress, ok := accessEgressDistance(legLines, toPoint, false); ok {
+			result.EgressWalkDistance = &egress
+		}
+
+		if opts.MaxWalkDistance > 0 {
+			if result.AccessWalkDistance != nil && *result.AccessWalkDistance > opts.MaxWalkDistance {
+				continue
+			}
+			if result.EgressWalkDistance != nil && *result.EgressWalkDistance > opts.MaxWalkDistance {
+				continue
+			}
+		}
+
+		patterns = append(patterns, result)
+	}
+
+	return &TripResult{TripPatterns: patterns}, nil
+}
+
+// decodeLegLines decodes each leg's pointsOnLink polyline, in leg order.
+// A nil entry marks a leg with no geometry (e.g. a wait).
+func decodeLegLines(pattern journeyplanner.TripPattern) []orb.LineString {
+	lines := make([]orb.LineString, len(pattern.Legs))
+	for i, leg := range pattern.Legs {
+		if leg.PointsOnLink == nil || leg.PointsOnLink.Points == "" {
+			continue
+		}
+
+		coords, _, err := polyline.DecodeCoords([]byte(leg.PointsOnLink.Points))
+		if err != nil {
+			log.Printf("Warning: failed to decode leg polyline: %v", err)
+			continue
+		}
+
+		line := make(orb.LineString, len(coords))
+		for j, coord := range coords {
+			line[j] = orb.Point{coord[1], coord[0]}
+		}
+		lines[i] = line
+	}
+	return lines
+}
+
+// patternGeometry builds a GeoJSON FeatureCollection of one LineString
+// feature per leg with geometry; nil if no leg in the pattern has one.
+func patternGeometry(pattern journeyplanner.TripPattern, legLines []orb.LineString) *geojson.FeatureCollection {
+	fc := geojson.NewFeatureCollection()
+	for i, line := range legLines {
+		if line == nil {
+			continue
+		}
+		feature := geojson.NewFeature(line)
+		feature.Properties = geojson.Properties{"mode": pattern.Legs[i].Mode}
+		fc.Append(feature)
+	}
+
+	if len(fc.Features) == 0 {
+		return nil
+	}
+	return fc
+}
+
+// accessEgressDistance projects point onto the access (first) or egress
+// (last) leg's geometry and returns the great-circle distance to the
+// closest point on it, correctly handling the case where Entur's leg
+// geometry doesn't exactly meet the requested coordinates.
+func accessEgressDistance(legLines []orb.LineString, point orb.Point, access bool) (float64, bool) {
+	if len(legLines) == 0 {
+		return 0, false
+	}
+
+	index := 0
+	if !access {
+		index = len(legLines) - 1
+	}
+
+	line := legLines[index]
+	if line == nil {
+		return 0, false
+	}
+
+	distance, _ := geoutils.DistanceFromLineString(point, line)
+	return distance, true
+}
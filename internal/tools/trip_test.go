@@ -0,0 +1,121 @@
+package tools
+
+import (
+	"testing"
+
+	polyline "github.com/twpayne/go-polyline"
+
+	"github.com/entur/opentripplanner-mcp/internal/entur/geocoder"
+	"github.com/entur/opentripplanner-mcp/internal/entur/journeyplanner"
+)
+
+// fakePlanner is a journeyplanner.API that returns a fixed response,
+// regardless of the query, so tests can exercise PlanTrip's re-scoring and
+// filtering logic without a real Journey Planner API.
+type fakePlanner struct {
+	trip    *journeyplanner.TripResponse
+	tripErr error
+}
+
+func (f *fakePlanner) Trip(query journeyplanner.TripQuery) (*journeyplanner.TripResponse, error) {
+	return f.trip, f.tripErr
+}
+
+func (f *fakePlanner) NearbyDepartures(query journeyplanner.NearbyDeparturesQuery) (*journeyplanner.NearbyDeparturesResponse, error) {
+	return nil, nil
+}
+
+// fakeGeocoder is a geocoderResolver that resolves every location to the
+// same fixed coordinates.
+type fakeGeocoder struct {
+	location *geocoder.Location
+}
+
+func (f *fakeGeocoder) Resolve(location string) (*geocoder.Location, error) {
+	return f.location, nil
+}
+
+// pointsOnLink encodes a single-point polyline at (lat, lng), matching the
+// shape a real Journey Planner leg's geometry takes.
+func pointsOnLink(lat, lng float64) *journeyplanner.PointsOnLink {
+	return &journeyplanner.PointsOnLink{
+		Points: string(polyline.EncodeCoords([][]float64{{lat, lng}})),
+	}
+}
+
+func TestPlanTripFiltersPatternOverMaxWalkDistance(t *testing.T) {
+	fromLoc := &geocoder.Location{Place: "from", Latitude: 59.91, Longitude: 10.75}
+
+	planner := &fakePlanner{trip: &journeyplanner.TripResponse{
+		TripPatterns: []journeyplanner.TripPattern{
+			{
+				// Access leg lands ~1.1km from the requested origin: over a
+				// 500m maxWalkDistance.
+				Legs: []journeyplanner.Leg{{Mode: "foot", PointsOnLink: pointsOnLink(59.92, 10.75)}},
+			},
+		},
+	}}
+
+	result, err := PlanTrip(planner, &fakeGeocoder{location: fromLoc}, "from", "to", "", "", 3, TripOptions{MaxWalkDistance: 500})
+	if err != nil {
+		t.Fatalf("PlanTrip returned error: %v", err)
+	}
+	if len(result.TripPatterns) != 0 {
+		t.Errorf("len(TripPatterns) = %d, want 0 (pattern should be filtered out)", len(result.TripPatterns))
+	}
+}
+
+func TestPlanTripKeepsPatternUnderMaxWalkDistance(t *testing.T) {
+	fromLoc := &geocoder.Location{Place: "from", Latitude: 59.91, Longitude: 10.75}
+
+	planner := &fakePlanner{trip: &journeyplanner.TripResponse{
+		TripPatterns: []journeyplanner.TripPattern{
+			{
+				// Access leg lands exactly on the requested origin: well
+				// under a 500m maxWalkDistance.
+				Legs: []journeyplanner.Leg{{Mode: "foot", PointsOnLink: pointsOnLink(59.91, 10.75)}},
+			},
+		},
+	}}
+
+	result, err := PlanTrip(planner, &fakeGeocoder{location: fromLoc}, "from", "to", "", "", 3, TripOptions{MaxWalkDistance: 500})
+	if err != nil {
+		t.Fatalf("PlanTrip returned error: %v", err)
+	}
+	if len(result.TripPatterns) != 1 {
+		t.Fatalf("len(TripPatterns) = %d, want 1 (pattern should be kept)", len(result.TripPatterns))
+	}
+	if result.TripPatterns[0].AccessWalkDistance == nil {
+		t.Fatal("AccessWalkDistance is nil, want a re-scored distance")
+	}
+	if *result.TripPatterns[0].AccessWalkDistance > 500 {
+		t.Errorf("AccessWalkDistance = %v, want <= 500", *result.TripPatterns[0].AccessWalkDistance)
+	}
+}
+
+func TestPlanTripLegWithoutGeometryIsNotFiltered(t *testing.T) {
+	fromLoc := &geocoder.Location{Place: "from", Latitude: 59.91, Longitude: 10.75}
+
+	planner := &fakePlanner{trip: &journeyplanner.TripResponse{
+		TripPatterns: []journeyplanner.TripPattern{
+			{
+				// No pointsOnLink at all, e.g. a wait leg.
+				Legs: []journeyplanner.Leg{{Mode: "wait"}},
+			},
+		},
+	}}
+
+	result, err := PlanTrip(planner, &fakeGeocoder{location: fromLoc}, "from", "to", "", "", 3, TripOptions{MaxWalkDistance: 500})
+	if err != nil {
+		t.Fatalf("PlanTrip returned error: %v", err)
+	}
+	if len(result.TripPatterns) != 1 {
+		t.Fatalf("len(TripPatterns) = %d, want 1 (pattern without geometry should not be filtered)", len(result.TripPatterns))
+	}
+	if result.TripPatterns[0].AccessWalkDistance != nil {
+		t.Errorf("AccessWalkDistance = %v, want nil (no geometry to score against)", *result.TripPatterns[0].AccessWalkDistance)
+	}
+	if result.TripPatterns[0].EgressWalkDistance != nil {
+		t.Errorf("EgressWalkDistance = %v, want nil (no geometry to score against)", *result.TripPatterns[0].EgressWalkDistance)
+	}
+}
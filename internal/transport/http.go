@@ -0,0 +1,21 @@
+package transport
+
+import (
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// ServeHTTP serves s over the MCP streamable-HTTP transport on the given
+// port, until the process is killed.
+func ServeHTTP(s *server.MCPServer, port int) error {
+	httpServer := server.NewStreamableHTTPServer(s)
+	return httpServer.Start(fmt.Sprintf(":%d", port))
+}
+
+// ServeSSE serves s over the older HTTP+SSE transport on the given port,
+// until the process is killed.
+func ServeSSE(s *server.MCPServer, port int) error {
+	sseServer := server.NewSSEServer(s)
+	return sseServer.Start(fmt.Sprintf(":%d", port))
+}
@@ -0,0 +1,12 @@
+// Package transport wires an *server.MCPServer up to a concrete transport:
+// stdio for local MCP clients, or streamable HTTP/SSE for hosted agents.
+package transport
+
+import (
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// ServeStdio serves s over stdio until the client disconnects.
+func ServeStdio(s *server.MCPServer) error {
+	return server.ServeStdio(s)
+}